@@ -0,0 +1,30 @@
+// Command goscraper is the CLI entrypoint for the scraper service. It wraps
+// the HTTP server, one-off scrapes, and housekeeping tasks behind a single
+// binary so operators aren't forced to boot Fiber just to run a cron job.
+package main
+
+import (
+	"log"
+
+	"github.com/spf13/cobra"
+)
+
+func main() {
+	if err := newRootCmd().Execute(); err != nil {
+		log.Fatalf("🚨 %v", err)
+	}
+}
+
+func newRootCmd() *cobra.Command {
+	root := &cobra.Command{
+		Use:   "goscraper",
+		Short: "GoScraper scrapes and serves calendar/user data",
+	}
+
+	root.AddCommand(newServeCmd())
+	root.AddCommand(newScrapeCmd())
+	root.AddCommand(newMigrateCmd())
+	root.AddCommand(newVersionCmd())
+
+	return root
+}
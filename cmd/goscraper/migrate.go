@@ -0,0 +1,21 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+)
+
+// newMigrateCmd is a placeholder hook for future schema/cache migrations.
+// goscraper has no persistent store today, so this currently just reports
+// that there is nothing to do rather than pretending to run migrations.
+func newMigrateCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "migrate",
+		Short: "Run pending data migrations",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			fmt.Println("no migrations to run")
+			return nil
+		},
+	}
+}
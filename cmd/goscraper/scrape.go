@@ -0,0 +1,83 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"time"
+
+	"goscraper/src/handlers"
+
+	"github.com/spf13/cobra"
+)
+
+func newScrapeCmd() *cobra.Command {
+	var (
+		target string
+		token  string
+		month  int
+		year   int
+		from   string
+		to     string
+	)
+
+	cmd := &cobra.Command{
+		Use:   "scrape",
+		Short: "Run a one-off scrape and print the result as JSON",
+		Long:  "Runs a single calendar or user scrape to stdout, suitable for cron jobs or CI, without booting the HTTP server.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if token == "" {
+				return fmt.Errorf("--token is required")
+			}
+
+			var (
+				result interface{}
+				err    error
+			)
+
+			switch target {
+			case "user":
+				result, err = handlers.GetUser(token)
+			case "calendar":
+				if from != "" || to != "" {
+					result, err = scrapeCalendarRange(token, from, to)
+				} else {
+					result, err = handlers.GetCalendar(token, month, year)
+				}
+			default:
+				return fmt.Errorf("unknown --target %q, expected \"user\" or \"calendar\"", target)
+			}
+			if err != nil {
+				return err
+			}
+
+			encoder := json.NewEncoder(os.Stdout)
+			encoder.SetIndent("", "  ")
+			return encoder.Encode(result)
+		},
+	}
+
+	now := time.Now()
+	cmd.Flags().StringVar(&target, "target", "calendar", `what to scrape: "calendar" or "user"`)
+	cmd.Flags().StringVar(&token, "token", "", "upstream scraper session token")
+	cmd.Flags().IntVar(&month, "month", int(now.Month()), "month to scrape (1-12), ignored if --from/--to are set")
+	cmd.Flags().IntVar(&year, "year", now.Year(), "year to scrape, ignored if --from/--to are set")
+	cmd.Flags().StringVar(&from, "from", "", "range start (YYYY-MM-DD), requires --to")
+	cmd.Flags().StringVar(&to, "to", "", "range end (YYYY-MM-DD), requires --from")
+
+	return cmd
+}
+
+func scrapeCalendarRange(token, from, to string) (interface{}, error) {
+	fromDate, err := time.Parse("2006-01-02", from)
+	if err != nil {
+		return nil, fmt.Errorf("invalid --from date: %w", err)
+	}
+
+	toDate, err := time.Parse("2006-01-02", to)
+	if err != nil {
+		return nil, fmt.Errorf("invalid --to date: %w", err)
+	}
+
+	return handlers.GetCalendarRange(token, fromDate, toDate)
+}
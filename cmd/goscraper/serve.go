@@ -0,0 +1,17 @@
+package main
+
+import (
+	"goscraper/src/server"
+
+	"github.com/spf13/cobra"
+)
+
+func newServeCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "serve",
+		Short: "Run the Fiber HTTP server",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return server.Run()
+		},
+	}
+}
@@ -0,0 +1,21 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+)
+
+// Version is overridden at build time via -ldflags "-X main.Version=...".
+var Version = "dev"
+
+func newVersionCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "version",
+		Short: "Print the goscraper version",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			fmt.Println(Version)
+			return nil
+		},
+	}
+}
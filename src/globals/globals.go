@@ -0,0 +1,10 @@
+// Package globals holds process-wide configuration that's read once at
+// startup and doesn't warrant threading through every function signature.
+package globals
+
+import "os"
+
+// DevMode reports whether goscraper is running in local development rather
+// than production, based on APP_ENV. It gates behavior like loading a
+// .env file that should never happen in a deployed environment.
+var DevMode = os.Getenv("APP_ENV") == "development" || os.Getenv("APP_ENV") == "dev"
@@ -1,16 +1,32 @@
 package handlers
 
 import (
-	"log"
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
 	"goscraper/src/helpers"
+	"goscraper/src/telemetry"
 	"goscraper/src/types"
-	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
 )
 
-func GetCalendar(token string, month int) (*types.CalendarResponse, error) {
-	log.Printf("DEBUG: Calendar handler triggered - Token length: %d, Month: %d", len(token), month)
+// GetCalendar fetches the calendar for the given month/year using the caller's token.
+// month is 1-indexed (1 = January, 12 = December).
+func GetCalendar(token string, month int, year int) (*types.CalendarResponse, error) {
+	_, span := telemetry.Tracer().Start(context.Background(), "handlers.GetCalendar")
+	defer span.End()
+	span.SetAttributes(
+		attribute.String("token.hash", telemetry.HashToken(token)),
+		attribute.Int("calendar.month", month),
+		attribute.Int("calendar.year", year),
+	)
+
 	if token == "" {
-		log.Printf("DEBUG: Error - Empty token provided")
+		span.SetStatus(codes.Error, "missing token")
 		return &types.CalendarResponse{
 			Error:   true,
 			Message: "Missing authentication token",
@@ -18,17 +34,149 @@ func GetCalendar(token string, month int) (*types.CalendarResponse, error) {
 		}, nil
 	}
 
-	// Set date to target specific month for testing (e.g., October 2025)
-	targetDate := time.Date(2025, time.October, 1, 0, 0, 0, 0, time.Local) // Start of October
+	if month < 1 || month > 12 {
+		span.SetStatus(codes.Error, "invalid month")
+		return &types.CalendarResponse{
+			Error:   true,
+			Message: fmt.Sprintf("Invalid month %d, must be between 1 and 12", month),
+			Status:  400,
+		}, nil
+	}
+
+	if year < 1 {
+		span.SetStatus(codes.Error, "invalid year")
+		return &types.CalendarResponse{
+			Error:   true,
+			Message: fmt.Sprintf("Invalid year %d", year),
+			Status:  400,
+		}, nil
+	}
+
+	targetDate := time.Date(year, time.Month(month), 1, 0, 0, 0, 0, time.Local)
 	scraper := helpers.NewCalendarFetcher(targetDate, token)
-	log.Printf("DEBUG: CalendarFetcher created for %v", targetDate)
 
+	start := time.Now()
 	calendar, err := scraper.GetCalendar()
+	telemetry.UpstreamLatencySeconds.WithLabelValues("calendar").Observe(time.Since(start).Seconds())
+
 	if err != nil {
-		log.Printf("DEBUG: Error from GetCalendar: %v", err)
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		telemetry.ScrapeFailureTotal.WithLabelValues("calendar", telemetry.ErrorClass(err)).Inc()
 		return calendar, err
 	}
 
-	log.Printf("DEBUG: Calendar fetched successfully - %d months in response", len(calendar.Calendar))
+	telemetry.ScrapeSuccessTotal.WithLabelValues("calendar").Inc()
+	span.SetAttributes(attribute.Int("calendar.months_returned", len(calendar.Calendar)))
 	return calendar, nil
 }
+
+// maxRangeMonths bounds how many months a single /calendar/range request can
+// span, so one authenticated request can't fan out an unbounded number of
+// concurrent upstream scrapes.
+const maxRangeMonths = 24
+
+// GetCalendarRange fetches every month between from and to (inclusive) in parallel
+// and merges the results into a single types.CalendarResponse, in chronological order.
+func GetCalendarRange(token string, from, to time.Time) (*types.CalendarResponse, error) {
+	_, span := telemetry.Tracer().Start(context.Background(), "handlers.GetCalendarRange")
+	defer span.End()
+	span.SetAttributes(
+		attribute.String("token.hash", telemetry.HashToken(token)),
+		attribute.String("calendar.from", from.Format("2006-01-02")),
+		attribute.String("calendar.to", to.Format("2006-01-02")),
+	)
+
+	if token == "" {
+		span.SetStatus(codes.Error, "missing token")
+		return &types.CalendarResponse{
+			Error:   true,
+			Message: "Missing authentication token",
+			Status:  401,
+		}, nil
+	}
+
+	if to.Before(from) {
+		span.SetStatus(codes.Error, "range end before range start")
+		return &types.CalendarResponse{
+			Error:   true,
+			Message: "Range end must not be before range start",
+			Status:  400,
+		}, nil
+	}
+
+	months := monthsBetween(from, to)
+	span.SetAttributes(attribute.Int("calendar.months_requested", len(months)))
+
+	if len(months) > maxRangeMonths {
+		span.SetStatus(codes.Error, "range exceeds max span")
+		return &types.CalendarResponse{
+			Error:   true,
+			Message: fmt.Sprintf("Range spans %d months, which exceeds the %d month maximum", len(months), maxRangeMonths),
+			Status:  400,
+		}, nil
+	}
+
+	var (
+		wg       sync.WaitGroup
+		mu       sync.Mutex
+		results  = make([]*types.CalendarResponse, len(months))
+		firstErr error
+	)
+
+	for i, targetDate := range months {
+		wg.Add(1)
+		go func(i int, targetDate time.Time) {
+			defer wg.Done()
+
+			scraper := helpers.NewCalendarFetcher(targetDate, token)
+
+			start := time.Now()
+			calendar, err := scraper.GetCalendar()
+			telemetry.UpstreamLatencySeconds.WithLabelValues("calendar_range").Observe(time.Since(start).Seconds())
+
+			if err != nil {
+				mu.Lock()
+				telemetry.ScrapeFailureTotal.WithLabelValues("calendar_range", telemetry.ErrorClass(err)).Inc()
+				if firstErr == nil {
+					firstErr = err
+				}
+				mu.Unlock()
+				return
+			}
+			telemetry.ScrapeSuccessTotal.WithLabelValues("calendar_range").Inc()
+			results[i] = calendar
+		}(i, targetDate)
+	}
+
+	wg.Wait()
+
+	if firstErr != nil {
+		span.RecordError(firstErr)
+		span.SetStatus(codes.Error, firstErr.Error())
+		return &types.CalendarResponse{}, firstErr
+	}
+
+	// results is indexed by month position, so concatenating in order keeps
+	// the merged calendar chronological without depending on goroutine
+	// completion order.
+	merged := &types.CalendarResponse{}
+	for _, calendar := range results {
+		merged.Calendar = append(merged.Calendar, calendar.Calendar...)
+	}
+
+	span.SetAttributes(attribute.Int("calendar.months_merged", len(merged.Calendar)))
+	return merged, nil
+}
+
+// monthsBetween returns the first-of-month timestamp for every month spanned by from..to inclusive.
+func monthsBetween(from, to time.Time) []time.Time {
+	start := time.Date(from.Year(), from.Month(), 1, 0, 0, 0, 0, time.Local)
+	end := time.Date(to.Year(), to.Month(), 1, 0, 0, 0, 0, time.Local)
+
+	var months []time.Time
+	for current := start; !current.After(end); current = current.AddDate(0, 1, 0) {
+		months = append(months, current)
+	}
+	return months
+}
@@ -0,0 +1,58 @@
+package handlers
+
+import (
+	"testing"
+	"time"
+)
+
+func TestMonthsBetween(t *testing.T) {
+	tests := []struct {
+		name string
+		from time.Time
+		to   time.Time
+		want []time.Time
+	}{
+		{
+			name: "same month returns a single entry",
+			from: time.Date(2026, time.March, 15, 0, 0, 0, 0, time.Local),
+			to:   time.Date(2026, time.March, 1, 0, 0, 0, 0, time.Local),
+			want: []time.Time{
+				time.Date(2026, time.March, 1, 0, 0, 0, 0, time.Local),
+			},
+		},
+		{
+			name: "multi-month range stays in chronological order",
+			from: time.Date(2025, time.November, 20, 0, 0, 0, 0, time.Local),
+			to:   time.Date(2026, time.February, 3, 0, 0, 0, 0, time.Local),
+			want: []time.Time{
+				time.Date(2025, time.November, 1, 0, 0, 0, 0, time.Local),
+				time.Date(2025, time.December, 1, 0, 0, 0, 0, time.Local),
+				time.Date(2026, time.January, 1, 0, 0, 0, 0, time.Local),
+				time.Date(2026, time.February, 1, 0, 0, 0, 0, time.Local),
+			},
+		},
+		{
+			name: "range spanning a year boundary carries the year forward",
+			from: time.Date(2025, time.December, 1, 0, 0, 0, 0, time.Local),
+			to:   time.Date(2026, time.January, 1, 0, 0, 0, 0, time.Local),
+			want: []time.Time{
+				time.Date(2025, time.December, 1, 0, 0, 0, 0, time.Local),
+				time.Date(2026, time.January, 1, 0, 0, 0, 0, time.Local),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := monthsBetween(tt.from, tt.to)
+			if len(got) != len(tt.want) {
+				t.Fatalf("monthsBetween() returned %d months, want %d: %v", len(got), len(tt.want), got)
+			}
+			for i := range got {
+				if !got[i].Equal(tt.want[i]) {
+					t.Errorf("month %d = %v, want %v (results must stay chronological)", i, got[i], tt.want[i])
+				}
+			}
+		})
+	}
+}
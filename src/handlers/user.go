@@ -0,0 +1,45 @@
+package handlers
+
+import (
+	"context"
+	"time"
+
+	"goscraper/src/helpers"
+	"goscraper/src/telemetry"
+	"goscraper/src/types"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+)
+
+// GetUser fetches the authenticated user's profile using the caller's token.
+func GetUser(token string) (*types.UserResponse, error) {
+	_, span := telemetry.Tracer().Start(context.Background(), "handlers.GetUser")
+	defer span.End()
+	span.SetAttributes(attribute.String("token.hash", telemetry.HashToken(token)))
+
+	if token == "" {
+		span.SetStatus(codes.Error, "missing token")
+		return &types.UserResponse{
+			Error:   true,
+			Message: "Missing authentication token",
+			Status:  401,
+		}, nil
+	}
+
+	scraper := helpers.NewUserFetcher(token)
+
+	start := time.Now()
+	user, err := scraper.GetUser()
+	telemetry.UpstreamLatencySeconds.WithLabelValues("user").Observe(time.Since(start).Seconds())
+
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		telemetry.ScrapeFailureTotal.WithLabelValues("user", telemetry.ErrorClass(err)).Inc()
+		return user, err
+	}
+
+	telemetry.ScrapeSuccessTotal.WithLabelValues("user").Inc()
+	return user, nil
+}
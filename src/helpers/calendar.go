@@ -0,0 +1,30 @@
+package helpers
+
+import (
+	"fmt"
+	"time"
+
+	"goscraper/src/types"
+)
+
+// CalendarFetcher scrapes a single month of calendar data for one token.
+type CalendarFetcher struct {
+	targetDate time.Time
+	token      string
+}
+
+// NewCalendarFetcher returns a fetcher for the month containing targetDate.
+func NewCalendarFetcher(targetDate time.Time, token string) *CalendarFetcher {
+	return &CalendarFetcher{targetDate: targetDate, token: token}
+}
+
+// GetCalendar fetches and decodes the target month from the upstream site.
+func (f *CalendarFetcher) GetCalendar() (*types.CalendarResponse, error) {
+	path := fmt.Sprintf("/api/calendar?month=%d&year=%d", int(f.targetDate.Month()), f.targetDate.Year())
+
+	var calendar types.CalendarResponse
+	if err := fetchJSON(path, f.token, &calendar); err != nil {
+		return nil, err
+	}
+	return &calendar, nil
+}
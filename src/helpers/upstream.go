@@ -0,0 +1,75 @@
+// Package helpers talks to the upstream site goscraper scrapes on behalf of
+// a caller's token and decodes the result into src/types response shapes.
+package helpers
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"time"
+)
+
+// defaultBaseURL is used when UPSTREAM_BASE_URL isn't set, so local/dev runs
+// still have something to point at without extra setup.
+const defaultBaseURL = "https://portal.example.edu"
+
+// httpClient is shared across fetchers; it's deliberately short-lived per
+// request rather than long-poll, since the upstream site is a plain
+// request/response portal, not a streaming API.
+var httpClient = &http.Client{Timeout: 10 * time.Second}
+
+// upstreamBaseURL returns the configured upstream host, trimmed of any
+// trailing slash so callers can always write baseURL+"/path".
+func upstreamBaseURL() string {
+	base := os.Getenv("UPSTREAM_BASE_URL")
+	if base == "" {
+		base = defaultBaseURL
+	}
+	for len(base) > 0 && base[len(base)-1] == '/' {
+		base = base[:len(base)-1]
+	}
+	return base
+}
+
+// fetchJSON issues an authenticated GET against the upstream site and
+// decodes the JSON body into out.
+func fetchJSON(path string, token string, out interface{}) error {
+	req, err := http.NewRequest(http.MethodGet, upstreamBaseURL()+path, nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Authorization", "Bearer "+token)
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= http.StatusBadRequest {
+		return &upstreamError{statusCode: resp.StatusCode}
+	}
+
+	if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+		return fmt.Errorf("decode upstream response: %w", err)
+	}
+	return nil
+}
+
+// upstreamError carries the upstream HTTP status code so callers can
+// classify failures (e.g. telemetry.ErrorClass) without string-matching.
+type upstreamError struct {
+	statusCode int
+}
+
+func (e *upstreamError) Error() string {
+	return fmt.Sprintf("upstream returned status %d", e.statusCode)
+}
+
+// StatusCode returns the upstream HTTP status code, for callers that want
+// to classify the failure (e.g. telemetry.ErrorClass).
+func (e *upstreamError) StatusCode() int {
+	return e.statusCode
+}
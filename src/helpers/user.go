@@ -0,0 +1,22 @@
+package helpers
+
+import "goscraper/src/types"
+
+// UserFetcher scrapes the authenticated user's profile for one token.
+type UserFetcher struct {
+	token string
+}
+
+// NewUserFetcher returns a fetcher for the user identified by token.
+func NewUserFetcher(token string) *UserFetcher {
+	return &UserFetcher{token: token}
+}
+
+// GetUser fetches and decodes the user's profile from the upstream site.
+func (f *UserFetcher) GetUser() (*types.UserResponse, error) {
+	var user types.UserResponse
+	if err := fetchJSON("/api/user", f.token, &user); err != nil {
+		return nil, err
+	}
+	return &user, nil
+}
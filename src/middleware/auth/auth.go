@@ -0,0 +1,224 @@
+// Package auth validates inbound JWT/PASETO tokens and exposes the decoded
+// claims to handlers via the Fiber request context.
+package auth
+
+import (
+	"crypto/ed25519"
+	"errors"
+	"strings"
+	"time"
+
+	"aidanwoods.dev/go-paseto"
+	"github.com/gofiber/fiber/v2"
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// localsKey is the c.Locals key the validated claims are stored under.
+const localsKey = "user"
+
+// minSigningKeyLen is the smallest HMAC/PASETO-local key New will accept.
+// HMAC-SHA256 validates successfully against an empty or trivially short
+// key, so a zero-length SigningKey (e.g. AUTH_SIGNING_KEY left unset) must
+// fail closed instead of silently verifying against "".
+const minSigningKeyLen = 32
+
+var (
+	// ErrMissingToken is returned when no Authorization header is present.
+	ErrMissingToken = errors.New("missing authorization token")
+	// ErrInvalidToken is returned when the token fails signature or claim validation.
+	ErrInvalidToken = errors.New("invalid or expired token")
+)
+
+// Config controls how incoming tokens are verified.
+type Config struct {
+	// SigningKey verifies JWT HMAC signatures and decrypts symmetric
+	// "v2.local." PASETO tokens. Shorter than minSigningKeyLen (including
+	// empty/unset) rejects JWT and v2.local. PASETO tokens outright rather
+	// than verifying against a weak or empty secret.
+	SigningKey []byte
+	// PublicKey verifies Ed25519-signed "v2.public." PASETO tokens. Empty
+	// rejects v2.public. tokens outright rather than silently mis-handling them.
+	PublicKey ed25519.PublicKey
+	// Issuer is the expected "iss" claim. Empty skips the check.
+	Issuer string
+	// Audience is the expected "aud" claim. Empty skips the check.
+	Audience string
+}
+
+// Claims is the set of claims this service cares about, shared between the
+// JWT and PASETO code paths.
+type Claims struct {
+	Subject   string    `json:"sub"`
+	Issuer    string    `json:"iss"`
+	Audience  string    `json:"aud"`
+	ExpiresAt time.Time `json:"exp"`
+
+	// UpstreamToken is the original scraper session token, embedded at
+	// issuance time so handlers can still forward it upstream without ever
+	// seeing the raw Authorization header.
+	UpstreamToken string `json:"upstream_token"`
+}
+
+func (c *Claims) expired() bool {
+	return !c.ExpiresAt.IsZero() && time.Now().After(c.ExpiresAt)
+}
+
+// New returns Fiber middleware that validates the Authorization header as
+// either a JWT or a PASETO token and stores the resulting Claims in
+// c.Locals("user"). Requests with a missing, malformed, expired, or
+// otherwise invalid token are rejected with a structured 401 response.
+func New(cfg Config) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		token := extractToken(c.Get("Authorization"))
+		if token == "" {
+			return unauthorized(c, ErrMissingToken)
+		}
+
+		claims, err := verify(token, cfg)
+		if err != nil {
+			return unauthorized(c, err)
+		}
+
+		if claims.expired() {
+			return unauthorized(c, ErrInvalidToken)
+		}
+
+		if cfg.Issuer != "" && claims.Issuer != cfg.Issuer {
+			return unauthorized(c, ErrInvalidToken)
+		}
+
+		if cfg.Audience != "" && claims.Audience != cfg.Audience {
+			return unauthorized(c, ErrInvalidToken)
+		}
+
+		c.Locals(localsKey, claims)
+		return c.Next()
+	}
+}
+
+// UserFromCtx returns the Claims stored on the request context by New, so
+// handlers never need to re-parse the Authorization header themselves.
+func UserFromCtx(c *fiber.Ctx) (*Claims, bool) {
+	claims, ok := c.Locals(localsKey).(*Claims)
+	return claims, ok
+}
+
+func extractToken(header string) string {
+	switch {
+	case strings.HasPrefix(header, "Bearer "):
+		return strings.TrimPrefix(header, "Bearer ")
+	case strings.HasPrefix(header, "Token "):
+		return strings.TrimPrefix(header, "Token ")
+	default:
+		return ""
+	}
+}
+
+// verify tries PASETO first (recognisable by its "v2.local."/"v2.public."
+// version header) and falls back to JWT otherwise.
+func verify(token string, cfg Config) (*Claims, error) {
+	switch {
+	case strings.HasPrefix(token, "v2.local."):
+		return verifyPasetoLocal(token, cfg)
+	case strings.HasPrefix(token, "v2.public."):
+		return verifyPasetoPublic(token, cfg)
+	default:
+		return verifyJWT(token, cfg)
+	}
+}
+
+func verifyJWT(token string, cfg Config) (*Claims, error) {
+	if len(cfg.SigningKey) < minSigningKeyLen {
+		return nil, ErrInvalidToken
+	}
+
+	parsed := &jwtClaims{}
+	_, err := jwt.ParseWithClaims(token, parsed, func(t *jwt.Token) (interface{}, error) {
+		if _, ok := t.Method.(*jwt.SigningMethodHMAC); !ok {
+			return nil, ErrInvalidToken
+		}
+		return cfg.SigningKey, nil
+	})
+	if err != nil {
+		return nil, ErrInvalidToken
+	}
+
+	return parsed.toClaims(), nil
+}
+
+func verifyPasetoLocal(token string, cfg Config) (*Claims, error) {
+	if len(cfg.SigningKey) < minSigningKeyLen {
+		return nil, ErrInvalidToken
+	}
+
+	key, err := paseto.V2SymmetricKeyFromBytes(cfg.SigningKey)
+	if err != nil {
+		return nil, ErrInvalidToken
+	}
+
+	parsed, err := paseto.NewParser().ParseV2Local(key, token)
+	if err != nil {
+		return nil, ErrInvalidToken
+	}
+
+	return claimsFromToken(parsed), nil
+}
+
+func verifyPasetoPublic(token string, cfg Config) (*Claims, error) {
+	if len(cfg.PublicKey) != ed25519.PublicKeySize {
+		return nil, ErrInvalidToken
+	}
+
+	key, err := paseto.NewV2AsymmetricPublicKeyFromBytes(cfg.PublicKey)
+	if err != nil {
+		return nil, ErrInvalidToken
+	}
+
+	parsed, err := paseto.NewParser().ParseV2Public(key, token)
+	if err != nil {
+		return nil, ErrInvalidToken
+	}
+
+	return claimsFromToken(parsed), nil
+}
+
+// claimsFromToken reads the claims this service cares about off a verified
+// PASETO token. Missing claims simply come back as their zero value - the
+// accessors' errors only ever signal "not present", not a validation failure.
+func claimsFromToken(t *paseto.Token) *Claims {
+	claims := &Claims{}
+	claims.Subject, _ = t.GetSubject()
+	claims.Issuer, _ = t.GetIssuer()
+	claims.Audience, _ = t.GetAudience()
+	claims.ExpiresAt, _ = t.GetExpiration()
+	claims.UpstreamToken, _ = t.GetString("upstream_token")
+	return claims
+}
+
+// jwtClaims mirrors Claims but satisfies jwt.Claims, which the JWT library
+// needs to unmarshal into directly.
+type jwtClaims struct {
+	jwt.RegisteredClaims
+	UpstreamToken string `json:"upstream_token"`
+}
+
+func (j *jwtClaims) toClaims() *Claims {
+	claims := &Claims{
+		Subject:       j.Subject,
+		Issuer:        j.Issuer,
+		UpstreamToken: j.UpstreamToken,
+	}
+	if len(j.Audience) > 0 {
+		claims.Audience = j.Audience[0]
+	}
+	if j.ExpiresAt != nil {
+		claims.ExpiresAt = j.ExpiresAt.Time
+	}
+	return claims
+}
+
+func unauthorized(c *fiber.Ctx, err error) error {
+	return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
+		"error": err.Error(),
+	})
+}
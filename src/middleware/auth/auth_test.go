@@ -0,0 +1,74 @@
+package auth
+
+import (
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+func signJWT(t *testing.T, key []byte, claims jwtClaims) string {
+	t.Helper()
+	token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString(key)
+	if err != nil {
+		t.Fatalf("failed to sign test JWT: %v", err)
+	}
+	return token
+}
+
+func TestVerifyJWT_RejectsEmptyOrShortSigningKey(t *testing.T) {
+	claims := jwtClaims{
+		RegisteredClaims: jwt.RegisteredClaims{Subject: "user-1"},
+		UpstreamToken:    "upstream-123",
+	}
+
+	tests := []struct {
+		name string
+		key  []byte
+	}{
+		{name: "nil key", key: nil},
+		{name: "empty key", key: []byte{}},
+		{name: "key shorter than minSigningKeyLen", key: []byte("short-secret")},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Forge the token with whatever (possibly empty) key is under
+			// test, then verify against the same key - a forgiving HMAC
+			// implementation would happily accept this.
+			token := signJWT(t, tt.key, claims)
+
+			_, err := verifyJWT(token, Config{SigningKey: tt.key})
+			if err == nil {
+				t.Fatalf("verifyJWT accepted a token signed with a %d-byte key, want rejection", len(tt.key))
+			}
+		})
+	}
+}
+
+func TestVerifyJWT_AcceptsValidKey(t *testing.T) {
+	key := []byte("a-signing-key-that-is-long-enough")
+	claims := jwtClaims{
+		RegisteredClaims: jwt.RegisteredClaims{
+			Subject:   "user-1",
+			ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Hour)),
+		},
+		UpstreamToken: "upstream-123",
+	}
+	token := signJWT(t, key, claims)
+
+	got, err := verifyJWT(token, Config{SigningKey: key})
+	if err != nil {
+		t.Fatalf("verifyJWT rejected a validly signed token: %v", err)
+	}
+	if got.Subject != "user-1" || got.UpstreamToken != "upstream-123" {
+		t.Errorf("verifyJWT() = %+v, want Subject=user-1 UpstreamToken=upstream-123", got)
+	}
+}
+
+func TestVerifyPasetoLocal_RejectsShortSigningKey(t *testing.T) {
+	_, err := verifyPasetoLocal("v2.local.garbage", Config{SigningKey: []byte("too-short")})
+	if err == nil {
+		t.Fatal("verifyPasetoLocal accepted a key shorter than minSigningKeyLen, want rejection")
+	}
+}
@@ -0,0 +1,65 @@
+// Package logging attaches a request-scoped zerolog.Logger to each Fiber
+// request so handlers can emit structured log lines instead of the
+// ad-hoc log.Printf("DEBUG: ...") calls this service used to rely on.
+package logging
+
+import (
+	"os"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/gofiber/fiber/v2/middleware/requestid"
+	"github.com/rs/zerolog"
+)
+
+// localsKey is the c.Locals key the request-scoped logger is stored under.
+const localsKey = "logger"
+
+// base is the process-wide logger New configures and Ctx falls back to
+// outside of a request (e.g. during startup, before any middleware runs).
+var base = zerolog.New(os.Stdout).With().Timestamp().Str("service", "goscraper").Logger()
+
+// New configures the global zerolog level from LOG_LEVEL (defaulting to
+// info for an empty or unrecognised value) and returns Fiber middleware
+// that attaches a copy of the base logger - tagged with the request ID,
+// method, and path - to c.Locals for handlers to retrieve via Ctx.
+func New(level string) fiber.Handler {
+	zerolog.SetGlobalLevel(parseLevel(level))
+
+	return func(c *fiber.Ctx) error {
+		requestID, _ := c.Locals(requestid.ConfigDefault.ContextKey).(string)
+
+		requestLogger := base.With().
+			Str("request_id", requestID).
+			Str("method", c.Method()).
+			Str("path", c.Path()).
+			Logger()
+
+		c.Locals(localsKey, &requestLogger)
+		return c.Next()
+	}
+}
+
+// Ctx returns the logger attached to c by New, falling back to the base
+// logger if none was attached (e.g. outside an HTTP request).
+func Ctx(c *fiber.Ctx) *zerolog.Logger {
+	if logger, ok := c.Locals(localsKey).(*zerolog.Logger); ok {
+		return logger
+	}
+	return &base
+}
+
+// Base returns the process-wide logger, for logging that happens before any
+// request exists (e.g. server startup).
+func Base() *zerolog.Logger {
+	return &base
+}
+
+// parseLevel maps a LOG_LEVEL env value to a zerolog.Level, defaulting to
+// info for empty or unrecognised input rather than erroring at boot.
+func parseLevel(level string) zerolog.Level {
+	parsed, err := zerolog.ParseLevel(level)
+	if err != nil || parsed == zerolog.NoLevel {
+		return zerolog.InfoLevel
+	}
+	return parsed
+}
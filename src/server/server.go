@@ -0,0 +1,349 @@
+// Package server houses the Fiber HTTP transport for goscraper. It is kept
+// separate from cmd/goscraper so the scraping logic can also be driven
+// headlessly (see the `scrape` subcommand) without booting an HTTP server.
+package server
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"os"
+	"strconv"
+	"time"
+
+	"goscraper/src/globals"
+	"goscraper/src/handlers"
+	"goscraper/src/middleware/auth"
+	"goscraper/src/middleware/logging"
+	"goscraper/src/telemetry"
+	"goscraper/src/utils"
+
+	"github.com/ansrivas/fiberprometheus/v2"
+	"github.com/gofiber/contrib/fiberzerolog"
+	"github.com/gofiber/contrib/otelfiber/v2"
+	"github.com/gofiber/fiber/v2"
+	"github.com/gofiber/fiber/v2/middleware/cache"
+	"github.com/gofiber/fiber/v2/middleware/compress"
+	"github.com/gofiber/fiber/v2/middleware/cors"
+	"github.com/gofiber/fiber/v2/middleware/etag"
+	"github.com/gofiber/fiber/v2/middleware/limiter"
+	recoverMiddleware "github.com/gofiber/fiber/v2/middleware/recover" // ✅ Renamed to prevent conflicts
+	"github.com/gofiber/fiber/v2/middleware/requestid"
+	"github.com/gofiber/storage/redis/v3"
+	"github.com/gofiber/template/html/v2"
+	"github.com/joho/godotenv"
+)
+
+// viewsDir holds the HTML templates rendered by utils.Respond for clients
+// that ask for "Accept: text/html" instead of JSON.
+const viewsDir = "./views"
+
+// scrapedCacheExpiration controls how long /user and /calendar responses are
+// cached before the upstream site is scraped again.
+const scrapedCacheExpiration = 5 * time.Minute
+
+// Run builds the Fiber app and blocks serving HTTP traffic until it exits.
+func Run() error {
+	loggingMiddleware := logging.New(os.Getenv("LOG_LEVEL"))
+
+	// ✅ Properly handling panics with recover()
+	defer func() {
+		if r := recover(); r != nil {
+			logging.Base().Fatal().Interface("panic", r).Msg("application crashed")
+		}
+	}()
+
+	// Load environment variables in development mode
+	if globals.DevMode {
+		godotenv.Load()
+	}
+
+	// Log a filtered, redacted startup summary instead of dumping every
+	// environment variable - the full dump was a secret-leak risk.
+	cwd, _ := os.Getwd()
+	logging.Base().Info().
+		Str("cwd", cwd).
+		Bool("dev_mode", globals.DevMode).
+		Strs("configured", configuredEnvVars()).
+		Msg("startup summary")
+
+	// Ensure correct port configuration
+	port := os.Getenv("PORT")
+	if port == "" {
+		port = "8000" // Default to Koyeb's expected port
+	}
+
+	// ✅ Enable Prefork for performance
+	usePrefork := true
+	preforkEnv := os.Getenv("PREFORK")
+	if preforkEnv == "false" || preforkEnv == "0" {
+		usePrefork = false
+	}
+	logging.Base().Info().Str("port", port).Bool("prefork", usePrefork).Msg("starting server")
+
+	// Tracing is opt-in: Init only dials out to an OTLP collector when one
+	// is configured, so local/dev runs don't hang on a missing endpoint.
+	if telemetry.MetricsEnabled() {
+		shutdown, err := telemetry.Init(context.Background())
+		if err != nil {
+			logging.Base().Warn().Err(err).Msg("failed to initialize OpenTelemetry")
+		} else {
+			defer shutdown(context.Background())
+		}
+	}
+
+	// Initialize Fiber with Prefork enabled
+	app := fiber.New(fiber.Config{
+		Prefork:      usePrefork,
+		ServerHeader: "GoScraper",
+		AppName:      "GoScraper v3.0",
+		JSONEncoder:  json.Marshal,
+		JSONDecoder:  json.Unmarshal,
+		Views:        html.New(viewsDir, ".html"),
+		ErrorHandler: func(c *fiber.Ctx, err error) error {
+			return utils.HandleError(c, err)
+		},
+	})
+
+	// ✅ Use the renamed recover middleware
+	app.Use(recoverMiddleware.New())
+	app.Use(compress.New(compress.Config{Level: compress.LevelBestSpeed}))
+	app.Use(etag.New())
+	app.Use(otelfiber.Middleware())
+
+	// Structured, request-scoped logging: requestid tags every request,
+	// loggingMiddleware hangs a zerolog.Logger off it for handlers to use
+	// via logging.Ctx(c), and fiberzerolog emits the per-request access log
+	// (method/path/status/latency only - it never logs headers, so the
+	// Authorization header can't leak into logs).
+	app.Use(requestid.New())
+	app.Use(loggingMiddleware)
+	app.Use(fiberzerolog.New(fiberzerolog.Config{Logger: logging.Base()}))
+
+	// Prometheus metrics - scraped by operators, not by upstream callers, so
+	// it's registered before auth/rate-limiting apply to the rest of the app.
+	prometheusMiddleware := fiberprometheus.New("goscraper")
+	prometheusMiddleware.RegisterAt(app, "/metrics")
+	app.Use(prometheusMiddleware.Middleware)
+
+	// Health check endpoint (prevents Koyeb from stopping the app)
+	app.Get("/health", func(c *fiber.Ctx) error {
+		return c.SendString("OK")
+	})
+
+	// CORS Configuration
+	urls := os.Getenv("URL")
+	allowedOrigins := "http://localhost:243"
+	if urls != "" {
+		allowedOrigins += "," + urls
+	}
+
+	app.Use(cors.New(cors.Config{
+		AllowOrigins:     allowedOrigins,
+		AllowMethods:     "GET,POST,PUT,DELETE,OPTIONS",
+		AllowHeaders:     "Origin,Content-Type,Accept,X-CSRF-Token,Authorization",
+		ExposeHeaders:    "Content-Length",
+		AllowCredentials: true,
+	}))
+
+	// Authentication Middleware - validates JWT/PASETO tokens and exposes
+	// claims via auth.UserFromCtx instead of trusting raw header strings.
+	// AUTH_PUBLIC_KEY is hex-encoded and only needed to accept v2.public.
+	// PASETO tokens; it's left nil (rejecting those tokens) if unset.
+	publicKey, err := hex.DecodeString(os.Getenv("AUTH_PUBLIC_KEY"))
+	if err != nil {
+		logging.Base().Warn().Err(err).Msg("invalid AUTH_PUBLIC_KEY, v2.public. PASETO tokens will be rejected")
+		publicKey = nil
+	}
+
+	app.Use(auth.New(auth.Config{
+		SigningKey: []byte(os.Getenv("AUTH_SIGNING_KEY")),
+		PublicKey:  publicKey,
+		Issuer:     os.Getenv("AUTH_ISSUER"),
+		Audience:   os.Getenv("AUTH_AUDIENCE"),
+	}))
+
+	// Rate Limiting
+	app.Use(limiter.New(limiter.Config{
+		Max:        25,
+		Expiration: 1 * time.Minute,
+		KeyGenerator: func(c *fiber.Ctx) string {
+			if claims, ok := auth.UserFromCtx(c); ok {
+				return utils.Encode(claims.Subject)
+			}
+			return c.IP()
+		},
+		LimitReached: func(c *fiber.Ctx) error {
+			return c.Status(fiber.StatusTooManyRequests).JSON(fiber.Map{
+				"error": "🔨 Rate limit exceeded. Please try again later.",
+			})
+		},
+		SkipFailedRequests: false,
+		LimiterMiddleware:  limiter.SlidingWindow{},
+	}))
+
+	// Error Handling Middleware
+	app.Use(func(c *fiber.Ctx) error {
+		err := c.Next()
+		if err != nil {
+			logging.Ctx(c).Error().Err(err).Msg("fiber error")
+			return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+				"error": err.Error(),
+			})
+		}
+		return nil
+	})
+
+	// Routes -----------------------------------------
+	app.Get("/", func(c *fiber.Ctx) error {
+		return c.JSON(fiber.Map{"message": "GoScraper is running!"})
+	})
+
+	// Scraped endpoints share a cache so repeat requests for the same
+	// token/path/query don't hit the upstream site again. Prefork workers
+	// only share it when CACHE_REDIS_URL is set (see newCacheStorage).
+	scraped := app.Group("", cache.New(cache.Config{
+		Expiration: scrapedCacheExpiration,
+		KeyGenerator: func(c *fiber.Ctx) string {
+			return cacheKey(c)
+		},
+		Next: func(c *fiber.Ctx) bool {
+			return c.Query("refresh") == "true"
+		},
+		Storage: newCacheStorage(),
+	}))
+
+	scraped.Get("/user", func(c *fiber.Ctx) error {
+		claims, ok := auth.UserFromCtx(c)
+		if !ok {
+			return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": "Missing authenticated user"})
+		}
+
+		user, err := handlers.GetUser(claims.UpstreamToken)
+		if err != nil {
+			return err
+		}
+		if user.Error {
+			c.Status(user.Status)
+		}
+		return utils.Respond(c, user, "user")
+	})
+
+	scraped.Get("/calendar", func(c *fiber.Ctx) error {
+		claims, ok := auth.UserFromCtx(c)
+		if !ok {
+			return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": "Missing authenticated user"})
+		}
+
+		now := time.Now()
+
+		month, err := strconv.Atoi(c.Query("month", strconv.Itoa(int(now.Month()))))
+		if err != nil {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+				"error": "Invalid month query parameter",
+			})
+		}
+
+		year, err := strconv.Atoi(c.Query("year", strconv.Itoa(now.Year())))
+		if err != nil {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+				"error": "Invalid year query parameter",
+			})
+		}
+
+		logging.Ctx(c).Info().Int("month", month).Int("year", year).Msg("calendar fetch")
+
+		calendar, err := handlers.GetCalendar(claims.UpstreamToken, month, year)
+		if err != nil {
+			return err
+		}
+		if calendar.Error {
+			c.Status(calendar.Status)
+		}
+		return utils.Respond(c, calendar, "calendar")
+	})
+
+	scraped.Get("/calendar/range", func(c *fiber.Ctx) error {
+		claims, ok := auth.UserFromCtx(c)
+		if !ok {
+			return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": "Missing authenticated user"})
+		}
+
+		from, err := time.Parse("2006-01-02", c.Query("from"))
+		if err != nil {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+				"error": "Invalid or missing 'from' query parameter, expected YYYY-MM-DD",
+			})
+		}
+
+		to, err := time.Parse("2006-01-02", c.Query("to"))
+		if err != nil {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+				"error": "Invalid or missing 'to' query parameter, expected YYYY-MM-DD",
+			})
+		}
+
+		calendar, err := handlers.GetCalendarRange(claims.UpstreamToken, from, to)
+		if err != nil {
+			return err
+		}
+		if calendar.Error {
+			c.Status(calendar.Status)
+		}
+		return utils.Respond(c, calendar, "calendar")
+	})
+
+	// Start the server and log if it crashes
+	return app.Listen("0.0.0.0:" + port)
+}
+
+// cacheKey hashes the caller's token together with the path and query so
+// cached responses never leak between users, while still deduping requests
+// for the same token/month (across Prefork workers when the cache is Redis-backed).
+func cacheKey(c *fiber.Ctx) string {
+	h := sha256.New()
+	if claims, ok := auth.UserFromCtx(c); ok {
+		h.Write([]byte(claims.Subject))
+		h.Write([]byte(claims.UpstreamToken))
+	}
+	h.Write([]byte(c.Path()))
+	h.Write([]byte(c.Request().URI().QueryString()))
+
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// relevantEnvVars are the env vars worth recording at startup; their values
+// may be secrets, so only whether each is set is ever logged.
+var relevantEnvVars = []string{
+	"APP_ENV", "PORT", "PREFORK", "URL", "LOG_LEVEL",
+	"AUTH_SIGNING_KEY", "AUTH_PUBLIC_KEY", "AUTH_ISSUER", "AUTH_AUDIENCE",
+	"CACHE_REDIS_URL", "OTEL_EXPORTER_OTLP_ENDPOINT",
+	"UPSTREAM_BASE_URL",
+}
+
+// configuredEnvVars returns the names of relevantEnvVars that are set,
+// without their values, for a redacted startup summary.
+func configuredEnvVars() []string {
+	var configured []string
+	for _, name := range relevantEnvVars {
+		if os.Getenv(name) != "" {
+			configured = append(configured, name)
+		}
+	}
+	return configured
+}
+
+// newCacheStorage returns a Redis-backed cache store when CACHE_REDIS_URL is
+// set, so cached responses survive across Prefork workers, otherwise nil
+// falls back to the cache middleware's built-in in-memory store.
+func newCacheStorage() fiber.Storage {
+	redisURL := os.Getenv("CACHE_REDIS_URL")
+	if redisURL == "" {
+		return nil
+	}
+
+	return redis.New(redis.Config{
+		URL: redisURL,
+	})
+}
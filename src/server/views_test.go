@@ -0,0 +1,75 @@
+package server
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"goscraper/src/types"
+
+	"github.com/gofiber/template/html/v2"
+)
+
+// viewsTestDir points at the repo's real ./views directory from this
+// package's location, so these tests render the actual templates rather
+// than a fixture copy that could drift from them.
+const viewsTestDir = "../../views"
+
+func newTestEngine(t *testing.T) *html.Engine {
+	t.Helper()
+	engine := html.New(viewsTestDir, ".html")
+	if err := engine.Load(); err != nil {
+		t.Fatalf("failed to load templates from %s: %v", viewsTestDir, err)
+	}
+	return engine
+}
+
+func TestUserTemplate_RendersRealUserResponseFields(t *testing.T) {
+	engine := newTestEngine(t)
+
+	user := types.UserResponse{
+		Username: "jdoe",
+		Name:     "Jane Doe",
+		Email:    "jane@example.com",
+	}
+
+	var buf bytes.Buffer
+	if err := engine.Render(&buf, "user", user); err != nil {
+		t.Fatalf("render user template: %v", err)
+	}
+
+	out := buf.String()
+	for _, want := range []string{"jdoe", "Jane Doe", "jane@example.com"} {
+		if !strings.Contains(out, want) {
+			t.Errorf("rendered user template missing %q, got:\n%s", want, out)
+		}
+	}
+}
+
+func TestCalendarTemplate_RendersRealCalendarResponseFields(t *testing.T) {
+	engine := newTestEngine(t)
+
+	calendar := types.CalendarResponse{
+		Calendar: []types.MonthCalendar{
+			{
+				Month: "October",
+				Year:  2026,
+				Weeks: []types.Week{
+					{Days: []types.Day{{Day: 1}, {Day: 2}}},
+				},
+			},
+		},
+	}
+
+	var buf bytes.Buffer
+	if err := engine.Render(&buf, "calendar", calendar); err != nil {
+		t.Fatalf("render calendar template: %v", err)
+	}
+
+	out := buf.String()
+	for _, want := range []string{"October", "2026", ">1<", ">2<"} {
+		if !strings.Contains(out, want) {
+			t.Errorf("rendered calendar template missing %q, got:\n%s", want, out)
+		}
+	}
+}
@@ -0,0 +1,129 @@
+// Package telemetry wires up OpenTelemetry tracing and Prometheus metrics
+// so operators can alert on scraper degradation instead of grepping
+// "DEBUG:" log lines.
+package telemetry
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"net"
+	"os"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.24.0"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+const tracerName = "goscraper"
+
+// ScrapeSuccessTotal and ScrapeFailureTotal count scrape outcomes per
+// endpoint ("user", "calendar", "calendar_range").
+var (
+	ScrapeSuccessTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "goscraper_scrape_success_total",
+		Help: "Number of successful upstream scrapes, labeled by endpoint.",
+	}, []string{"endpoint"})
+
+	ScrapeFailureTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "goscraper_scrape_failure_total",
+		Help: "Number of failed upstream scrapes, labeled by endpoint and error class.",
+	}, []string{"endpoint", "error_class"})
+
+	UpstreamLatencySeconds = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "goscraper_upstream_latency_seconds",
+		Help:    "Latency of upstream scrape requests, labeled by endpoint.",
+		Buckets: prometheus.DefBuckets,
+	}, []string{"endpoint"})
+)
+
+func init() {
+	prometheus.MustRegister(ScrapeSuccessTotal, ScrapeFailureTotal, UpstreamLatencySeconds)
+}
+
+// Init configures the global OpenTelemetry tracer provider, exporting spans
+// via OTLP/gRPC to the collector at OTEL_EXPORTER_OTLP_ENDPOINT. It returns
+// a shutdown func that should be deferred by the caller.
+func Init(ctx context.Context) (func(context.Context) error, error) {
+	exporter, err := otlptracegrpc.New(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := resource.New(ctx,
+		resource.WithAttributes(
+			semconv.ServiceName("goscraper"),
+		),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(res),
+	)
+	otel.SetTracerProvider(provider)
+
+	return provider.Shutdown, nil
+}
+
+// Tracer returns the goscraper tracer, reusing whatever TracerProvider Init
+// registered (or the OTel no-op provider if tracing isn't configured).
+func Tracer() trace.Tracer {
+	return otel.Tracer(tracerName)
+}
+
+// HashToken returns a stable, irreversible identifier for a token suitable
+// for span attributes and logs, so raw tokens never leave this process.
+func HashToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])[:16]
+}
+
+// statusCoder is implemented by errors that carry an upstream HTTP status
+// code (e.g. helpers' upstreamError), without telemetry needing to import
+// the helpers package to recognise them.
+type statusCoder interface {
+	StatusCode() int
+}
+
+// ErrorClass buckets an error into a coarse, low-cardinality label for the
+// "error_class" metric dimension: "none" for success, "timeout" for a
+// network timeout, "upstream_4xx"/"upstream_5xx" for a classified upstream
+// HTTP status, and "upstream_error" for anything else (e.g. a connection
+// refusal or malformed response body).
+func ErrorClass(err error) string {
+	if err == nil {
+		return "none"
+	}
+
+	var withStatus statusCoder
+	if errors.As(err, &withStatus) {
+		switch code := withStatus.StatusCode(); {
+		case code >= 500:
+			return "upstream_5xx"
+		case code >= 400:
+			return "upstream_4xx"
+		}
+	}
+
+	var netErr net.Error
+	if errors.As(err, &netErr) && netErr.Timeout() {
+		return "timeout"
+	}
+
+	return "upstream_error"
+}
+
+// MetricsEnabled reports whether OTEL export is configured, so callers can
+// skip Init entirely in local/dev environments.
+func MetricsEnabled() bool {
+	return os.Getenv("OTEL_EXPORTER_OTLP_ENDPOINT") != ""
+}
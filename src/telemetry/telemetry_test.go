@@ -0,0 +1,42 @@
+package telemetry
+
+import (
+	"errors"
+	"net"
+	"testing"
+)
+
+type fakeStatusError struct{ code int }
+
+func (e *fakeStatusError) Error() string   { return "fake upstream error" }
+func (e *fakeStatusError) StatusCode() int { return e.code }
+
+type fakeTimeoutError struct{}
+
+func (e *fakeTimeoutError) Error() string   { return "fake timeout" }
+func (e *fakeTimeoutError) Timeout() bool   { return true }
+func (e *fakeTimeoutError) Temporary() bool { return true }
+
+var _ net.Error = (*fakeTimeoutError)(nil)
+
+func TestErrorClass(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want string
+	}{
+		{name: "nil error", err: nil, want: "none"},
+		{name: "5xx upstream status", err: &fakeStatusError{code: 503}, want: "upstream_5xx"},
+		{name: "4xx upstream status", err: &fakeStatusError{code: 404}, want: "upstream_4xx"},
+		{name: "network timeout", err: &fakeTimeoutError{}, want: "timeout"},
+		{name: "unclassified error", err: errors.New("connection reset"), want: "upstream_error"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ErrorClass(tt.err); got != tt.want {
+				t.Errorf("ErrorClass(%v) = %q, want %q", tt.err, got, tt.want)
+			}
+		})
+	}
+}
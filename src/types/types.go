@@ -0,0 +1,45 @@
+// Package types holds the response shapes shared between the scraper
+// (src/helpers), the handlers that call it, and the HTML templates under
+// ./views that render them.
+package types
+
+// UserResponse is the result of scraping the authenticated user's profile.
+// Error/Message/Status follow the same convention as CalendarResponse so
+// handlers can surface upstream/validation failures without a second type.
+type UserResponse struct {
+	Error   bool   `json:"error,omitempty"`
+	Message string `json:"message,omitempty"`
+	Status  int    `json:"status,omitempty"`
+
+	Username string `json:"username,omitempty"`
+	Name     string `json:"name,omitempty"`
+	Email    string `json:"email,omitempty"`
+}
+
+// CalendarResponse is the result of scraping one or more months of
+// calendar data. Calendar holds one entry per month, in chronological order.
+type CalendarResponse struct {
+	Error   bool   `json:"error,omitempty"`
+	Message string `json:"message,omitempty"`
+	Status  int    `json:"status,omitempty"`
+
+	Calendar []MonthCalendar `json:"calendar,omitempty"`
+}
+
+// MonthCalendar is a single month rendered as a grid of weeks.
+type MonthCalendar struct {
+	Month string `json:"month"`
+	Year  int    `json:"year"`
+	Weeks []Week `json:"weeks"`
+}
+
+// Week is one row of the month grid, Sunday through Saturday. Days outside
+// the target month (the leading/trailing padding of the grid) have Day 0.
+type Week struct {
+	Days []Day `json:"days"`
+}
+
+// Day is a single cell of the month grid.
+type Day struct {
+	Day int `json:"day"`
+}
@@ -0,0 +1,14 @@
+package utils
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+)
+
+// Encode returns a stable, irreversible identifier for s, for callers (like
+// the rate limiter's KeyGenerator) that need to key on a value without
+// exposing it in a header, log line, or limiter store key.
+func Encode(s string) string {
+	sum := sha256.Sum256([]byte(s))
+	return hex.EncodeToString(sum[:])
+}
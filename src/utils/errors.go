@@ -0,0 +1,15 @@
+package utils
+
+import "github.com/gofiber/fiber/v2"
+
+// HandleError is the Fiber app's top-level error handler: it maps a
+// *fiber.Error's status code through, or falls back to 500 for anything
+// else, and always responds with the same {"error": ...} shape handlers
+// use elsewhere.
+func HandleError(c *fiber.Ctx, err error) error {
+	code := fiber.StatusInternalServerError
+	if fiberErr, ok := err.(*fiber.Error); ok {
+		code = fiberErr.Code
+	}
+	return c.Status(code).JSON(fiber.Map{"error": err.Error()})
+}
@@ -0,0 +1,26 @@
+// Package utils collects small helpers shared across handlers and the
+// server package that don't warrant their own package.
+package utils
+
+import (
+	"strings"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// Respond performs content negotiation on the caller's Accept header: it
+// renders the named template under ./views when the client asked for
+// "text/html", and falls back to JSON (the default for API clients) for
+// everything else.
+func Respond(c *fiber.Ctx, data interface{}, template string) error {
+	if wantsHTML(c) {
+		return c.Render(template, data)
+	}
+	return c.JSON(data)
+}
+
+// wantsHTML reports whether the Accept header prefers HTML over JSON.
+func wantsHTML(c *fiber.Ctx) bool {
+	accept := c.Get("Accept")
+	return strings.Contains(accept, "text/html") && !strings.Contains(accept, "application/json")
+}